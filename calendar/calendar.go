@@ -0,0 +1,114 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package calendar provides business-day arithmetic on civil.Date values,
+// relative to a pluggable Calendar of holidays. It exists so that
+// financial and HR applications do not need to reimplement business-day
+// math on top of civil.Date.AddDays by repeatedly converting through
+// time.Time in UTC.
+package calendar
+
+import "github.com/openlyinc/civil"
+
+// A Calendar reports which dates are holidays and, by extension, which
+// are business days.
+type Calendar interface {
+	// IsHoliday reports whether d is a holiday.
+	IsHoliday(d civil.Date) bool
+
+	// IsBusinessDay reports whether d is a business day, i.e. not a
+	// weekend and not a holiday.
+	IsBusinessDay(d civil.Date) bool
+}
+
+// WeekendCalendar is a Calendar with no holidays: every day is a business
+// day except Saturdays and Sundays.
+var WeekendCalendar Calendar = weekendCalendar{}
+
+type weekendCalendar struct{}
+
+func (weekendCalendar) IsHoliday(civil.Date) bool { return false }
+
+func (weekendCalendar) IsBusinessDay(d civil.Date) bool {
+	wd := d.Weekday()
+	return wd != 0 && wd != 6 // not Sunday, not Saturday
+}
+
+// A CompositeCalendar overlays a set of holiday dates, typically loaded
+// from an iCalendar VEVENT list, on top of a base Calendar. A date is a
+// holiday if either the base Calendar or the overlay says so.
+type CompositeCalendar struct {
+	Base     Calendar
+	Holidays map[civil.Date]bool
+}
+
+// NewCompositeCalendar returns a CompositeCalendar overlaying holidays on
+// base. If base is nil, WeekendCalendar is used.
+func NewCompositeCalendar(base Calendar, holidays ...civil.Date) *CompositeCalendar {
+	if base == nil {
+		base = WeekendCalendar
+	}
+	h := make(map[civil.Date]bool, len(holidays))
+	for _, d := range holidays {
+		h[d] = true
+	}
+	return &CompositeCalendar{Base: base, Holidays: h}
+}
+
+// IsHoliday reports whether d is a holiday, either in the overlay or in
+// the base Calendar.
+func (c *CompositeCalendar) IsHoliday(d civil.Date) bool {
+	return c.Holidays[d] || c.Base.IsHoliday(d)
+}
+
+// IsBusinessDay reports whether d is a business day: not a weekend day
+// (per the base Calendar) and not an overlaid holiday.
+func (c *CompositeCalendar) IsBusinessDay(d civil.Date) bool {
+	return c.Base.IsBusinessDay(d) && !c.Holidays[d]
+}
+
+// AddBusinessDays returns the date that is n business days in the future
+// from d, as determined by c, skipping over any day for which
+// c.IsBusinessDay reports false. n can also be negative to go into the
+// past.
+func AddBusinessDays(d civil.Date, n int, c Calendar) civil.Date {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days between a and
+// b, as determined by c, not including the end day. It is negative if b
+// is before a.
+func BusinessDaysBetween(a, b civil.Date, c Calendar) int {
+	if b.Before(a) {
+		return -BusinessDaysBetween(b, a, c)
+	}
+	count := 0
+	for d := a; d.Before(b); d = d.AddDays(1) {
+		if c.IsBusinessDay(d) {
+			count++
+		}
+	}
+	return count
+}
@@ -58,16 +58,12 @@ func ParseDate(s string) (Date, error) {
 	if s == dateZero {
 		return Date{}, nil
 	}
-	t, err := time.Parse(RFC3339Date, s)
-	if err != nil {
-		return Date{}, err
-	}
-	return DateOf(t), nil
+	return parseDate(s)
 }
 
 // String returns the date in RFC3339 full-date format.
 func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	return string(d.AppendFormat(make([]byte, 0, len(RFC3339Date))))
 }
 
 // IsValid reports whether the date is valid.
@@ -146,33 +142,18 @@ func (d *Date) UnmarshalText(data []byte) error {
 	return err
 }
 
-// UnmarshalJSON implements encoding/json Unmarshaler interface
+// UnmarshalJSON implements encoding/json Unmarshaler interface. It is a
+// thin wrapper over UnmarshalJSONWith using DefaultFormatOptions; see
+// UnmarshalJSONWith for more control over accepted formats.
 func (d *Date) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("date should be a string, got %s", data)
-	}
-	val, err := ParseDate(s)
-	if err != nil {
-		return fmt.Errorf("invalid date, data: %s, err: %v", s, err)
-	}
-	*d = val
-	return nil
+	return d.UnmarshalJSONWith(data, DefaultFormatOptions)
 }
 
-// MarshalJSON implements encoding/json Marshaler interface
+// MarshalJSON implements encoding/json Marshaler interface. It is a thin
+// wrapper over MarshalJSONWith using DefaultFormatOptions; see
+// MarshalJSONWith for more control over the emitted format.
 func (d *Date) MarshalJSON() ([]byte, error) {
-	if y := d.Year; y < 0 || y >= 10000 {
-		// RFC 3339 is clear that years are 4 digits exactly.
-		// See golang.org/issue/4556#c15 for more discussion.
-		return nil, fmt.Errorf("Date.MarshalJSON: year '%v' outside of range [0,9999]", y)
-	}
-
-	b := make([]byte, 0, len(RFC3339Date)+2)
-	b = append(b, '"')
-	b = append(b, d.String()...)
-	b = append(b, '"')
-	return b, nil
+	return d.MarshalJSONWith(DefaultFormatOptions)
 }
 
 // Value implements the database/sql/driver valuer interface.
@@ -238,22 +219,14 @@ const RFC3339Time = "15:04:05.999999999"
 // consisting of a decimal point followed by one to nine decimal digits.
 // (RFC3339 admits only one digit after the decimal point).
 func ParseTime(s string) (Time, error) {
-	t, err := time.Parse(RFC3339Time, s)
-	if err != nil {
-		return Time{}, err
-	}
-	return TimeOf(t), nil
+	return parseTime(s)
 }
 
 // String returns the date in the format described in ParseTime. If Nanoseconds
 // is zero, no fractional part will be generated. Otherwise, the result will
 // end with a fractional part consisting of a decimal point and nine digits.
 func (t Time) String() string {
-	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
-	if t.Nanosecond == 0 {
-		return s
-	}
-	return s + fmt.Sprintf(".%09d", t.Nanosecond)
+	return string(t.AppendFormat(make([]byte, 0, len(RFC3339Time))))
 }
 
 // IsValid reports whether the time is valid.
@@ -277,27 +250,18 @@ func (t *Time) UnmarshalText(data []byte) error {
 	return err
 }
 
-// UnmarshalJSON implements encoding/json Unmarshaler interface
+// UnmarshalJSON implements encoding/json Unmarshaler interface. It is a
+// thin wrapper over UnmarshalJSONWith using DefaultFormatOptions; see
+// UnmarshalJSONWith for more control over accepted formats.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("time should be a string, got %s", data)
-	}
-	val, err := ParseTime(s)
-	if err != nil {
-		return fmt.Errorf("invalid time: %v", err)
-	}
-	*t = val
-	return nil
+	return t.UnmarshalJSONWith(data, DefaultFormatOptions)
 }
 
-// MarshalJSON implements encoding/json Marshaler interface
+// MarshalJSON implements encoding/json Marshaler interface. It is a thin
+// wrapper over MarshalJSONWith using DefaultFormatOptions; see
+// MarshalJSONWith for more control over the emitted format.
 func (t *Time) MarshalJSON() ([]byte, error) {
-	b := make([]byte, 0, len(RFC3339Time)+2)
-	b = append(b, '"')
-	b = append(b, t.String()...)
-	b = append(b, '"')
-	return b, nil
+	return t.MarshalJSONWith(DefaultFormatOptions)
 }
 
 // Value implements the database/sql/driver valuer interface.
@@ -360,19 +324,12 @@ const RFC3339DateTime = "2006-01-02T15:04:05.999999999"
 //     YYYY-MM-DDTHH:MM:SS[.FFFFFFFFF]
 // where the 'T' may be a lower-case 't'.
 func ParseDateTime(s string) (DateTime, error) {
-	t, err := time.Parse(RFC3339DateTime, s)
-	if err != nil {
-		t, err = time.Parse("2006-01-02t15:04:05.999999999", s)
-		if err != nil {
-			return DateTime{}, err
-		}
-	}
-	return DateTimeOf(t), nil
+	return parseDateTime(s)
 }
 
 // String returns the date in the format described in ParseDate.
 func (dt DateTime) String() string {
-	return dt.Date.String() + "T" + dt.Time.String()
+	return string(dt.AppendFormat(make([]byte, 0, len(RFC3339DateTime))))
 }
 
 // IsValid reports whether the datetime is valid.
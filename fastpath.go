@@ -0,0 +1,256 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// This file contains hand-written parse and format fast paths for Date,
+// Time and DateTime that avoid going through time.Parse/time.Format (and
+// the intermediate time.Time those construct) and avoid the heap
+// allocations that fmt.Sprintf brings with it. High-throughput consumers
+// of civil values, such as log ingestion or BigQuery result decoding, spend
+// a surprising fraction of their CPU here.
+
+// parseDigits reads exactly n ASCII decimal digits from s starting at
+// index i. It reports ok=false, without modifying v, if s is too short or
+// any of the n bytes is not a digit.
+func parseDigits(s string, i, n int) (v, next int, ok bool) {
+	if i+n > len(s) {
+		return 0, i, false
+	}
+	for j := 0; j < n; j++ {
+		c := s[i+j]
+		if c < '0' || c > '9' {
+			return 0, i, false
+		}
+		v = v*10 + int(c-'0')
+	}
+	return v, i + n, true
+}
+
+// parseDate is the fast path behind ParseDate for anything but the
+// "0000-00-00" zero date, which ParseDate special-cases itself.
+func parseDate(s string) (Date, error) {
+	year, i, ok := parseDigits(s, 0, 4)
+	if ok && i < len(s) && s[i] == '-' {
+		i++
+	} else {
+		ok = false
+	}
+	var month, day int
+	if ok {
+		month, i, ok = parseDigits(s, i, 2)
+	}
+	if ok && i < len(s) && s[i] == '-' {
+		i++
+	} else {
+		ok = false
+	}
+	if ok {
+		day, i, ok = parseDigits(s, i, 2)
+	}
+	if !ok || i != len(s) || month < 1 || month > 12 || day < 1 || day > daysInMonth(year, month) {
+		return Date{}, fmt.Errorf(`civil: invalid date %q, want format "2006-01-02"`, s)
+	}
+	return Date{Year: year, Month: time.Month(month), Day: day}, nil
+}
+
+// daysInMonth returns the number of days in the given month of the given
+// year, accounting for leap years in February. month must be in [1, 12].
+func daysInMonth(year, month int) int {
+	switch month {
+	case 1, 3, 5, 7, 8, 10, 12:
+		return 31
+	case 4, 6, 9, 11:
+		return 30
+	default: // 2: February
+		if isLeapYear(year) {
+			return 29
+		}
+		return 28
+	}
+}
+
+// isLeapYear reports whether year is a leap year in the proleptic
+// Gregorian calendar.
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// parseVariableWidthDigits reads 1 to maxWidth ASCII decimal digits from s
+// starting at index i, stopping at the first non-digit byte. It reports
+// ok=false if there is no digit at i at all.
+func parseVariableWidthDigits(s string, i, maxWidth int) (v, next int, ok bool) {
+	start := i
+	for i < len(s) && i-start < maxWidth && s[i] >= '0' && s[i] <= '9' {
+		v = v*10 + int(s[i]-'0')
+		i++
+	}
+	if i == start {
+		return 0, start, false
+	}
+	return v, i, true
+}
+
+// parseTime is the fast path behind ParseTime. Like the time.Parse-based
+// implementation it replaces, the hour accepts either one or two digits
+// (matching the "15" reference layout element, which time.Parse does not
+// zero-pad-require on input); minutes and seconds require exactly two
+// digits.
+func parseTime(s string) (Time, error) {
+	hour, i, ok := parseVariableWidthDigits(s, 0, 2)
+	if ok && i < len(s) && s[i] == ':' {
+		i++
+	} else {
+		ok = false
+	}
+	var minute, second int
+	if ok {
+		minute, i, ok = parseDigits(s, i, 2)
+	}
+	if ok && i < len(s) && s[i] == ':' {
+		i++
+	} else {
+		ok = false
+	}
+	if ok {
+		second, i, ok = parseDigits(s, i, 2)
+	}
+	if !ok || hour > 23 || minute > 59 || second > 59 {
+		return Time{}, fmt.Errorf(`civil: invalid time %q, want format "15:04:05[.999999999]"`, s)
+	}
+	nsec := 0
+	if i < len(s) {
+		if s[i] != '.' {
+			return Time{}, fmt.Errorf(`civil: invalid time %q, want format "15:04:05[.999999999]"`, s)
+		}
+		i++
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		digits := i - start
+		if digits == 0 || digits > 9 || i != len(s) {
+			return Time{}, fmt.Errorf(`civil: invalid time %q, want format "15:04:05[.999999999]"`, s)
+		}
+		frac, _, _ := parseDigits(s, start, digits)
+		// Scale frac, which has `digits` digits, up to nanoseconds (9 digits).
+		for k := digits; k < 9; k++ {
+			frac *= 10
+		}
+		nsec = frac
+	}
+	return Time{Hour: hour, Minute: minute, Second: second, Nanosecond: nsec}, nil
+}
+
+// parseDateTime is the fast path behind ParseDateTime.
+func parseDateTime(s string) (DateTime, error) {
+	tIdx := -1
+	for i := 0; i < len(s); i++ {
+		if s[i] == 'T' || s[i] == 't' {
+			tIdx = i
+			break
+		}
+	}
+	if tIdx < 0 {
+		return DateTime{}, fmt.Errorf(`civil: invalid date-time %q, missing "T"`, s)
+	}
+	d, err := parseDate(s[:tIdx])
+	if err != nil {
+		return DateTime{}, err
+	}
+	t, err := parseTime(s[tIdx+1:])
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: t}, nil
+}
+
+// appendZeroPadded appends n to b, left-padded with zeros to at least
+// width digits. It matches the behavior of fmt's "%0<width>d" verb,
+// including how it handles a negative n (the sign is emitted first and
+// counts towards width).
+func appendZeroPadded(b []byte, n, width int) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		return appendZeroPadded(b, -n, width-1)
+	}
+	start := len(b)
+	b = strconv.AppendInt(b, int64(n), 10)
+	if digits := len(b) - start; digits < width {
+		pad := width - digits
+		b = append(b, make([]byte, pad)...)
+		copy(b[start+pad:], b[start:start+digits])
+		for i := 0; i < pad; i++ {
+			b[start+i] = '0'
+		}
+	}
+	return b
+}
+
+// AppendFormat appends the RFC3339 full-date representation of d to b and
+// returns the extended buffer.
+func (d Date) AppendFormat(b []byte) []byte {
+	b = appendZeroPadded(b, d.Year, 4)
+	b = append(b, '-')
+	b = appendZeroPadded(b, int(d.Month), 2)
+	b = append(b, '-')
+	b = appendZeroPadded(b, d.Day, 2)
+	return b
+}
+
+// AppendFormat appends the representation of t described in ParseTime to
+// b and returns the extended buffer.
+func (t Time) AppendFormat(b []byte) []byte {
+	b = appendZeroPadded(b, t.Hour, 2)
+	b = append(b, ':')
+	b = appendZeroPadded(b, t.Minute, 2)
+	b = append(b, ':')
+	b = appendZeroPadded(b, t.Second, 2)
+	if t.Nanosecond == 0 {
+		return b
+	}
+	b = append(b, '.')
+	return appendZeroPadded(b, t.Nanosecond, 9)
+}
+
+// AppendFormat appends the representation of dt described in ParseDateTime to
+// b and returns the extended buffer.
+func (dt DateTime) AppendFormat(b []byte) []byte {
+	b = dt.Date.AppendFormat(b)
+	b = append(b, 'T')
+	return dt.Time.AppendFormat(b)
+}
+
+// unquoteSimple reports the unquoted contents of data, which must be a
+// JSON string, and true, if data contains no backslash escapes -- the
+// common case for civil wire values. Otherwise it reports ok=false so the
+// caller can fall back to json.Unmarshal.
+func unquoteSimple(data []byte) (s string, ok bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	inner := data[1 : len(data)-1]
+	for _, c := range inner {
+		if c == '\\' {
+			return "", false
+		}
+	}
+	return string(inner), true
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func BenchmarkParseDate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDate("2016-01-02"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseTime(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseTime("15:04:05.999999999"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseDateTime(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDateTime("2016-01-02T15:04:05.999999999"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDateString(b *testing.B) {
+	d := Date{Year: 2016, Month: 1, Day: 2}
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkTimeString(b *testing.B) {
+	t := Time{Hour: 15, Minute: 4, Second: 5, Nanosecond: 999999999}
+	for i := 0; i < b.N; i++ {
+		_ = t.String()
+	}
+}
+
+func BenchmarkDateTimeString(b *testing.B) {
+	dt := DateTime{Date: Date{Year: 2016, Month: 1, Day: 2}, Time: Time{Hour: 15, Minute: 4, Second: 5, Nanosecond: 999999999}}
+	for i := 0; i < b.N; i++ {
+		_ = dt.String()
+	}
+}
+
+func TestParseDateFastPath(t *testing.T) {
+	got, err := ParseDate("2016-01-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Date{Year: 2016, Month: 1, Day: 2}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if _, err := ParseDate("2016-13-02"); err == nil {
+		t.Error("expected error for out-of-range month, got nil")
+	}
+	for _, s := range []string{
+		"2016-02-30", // February never has 30 days
+		"2016-02-31", // February never has 31 days
+		"2015-02-29", // 2015 is not a leap year
+		"2016-04-31", // April has 30 days
+	} {
+		if _, err := ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q): expected error for invalid calendar date, got nil", s)
+		}
+	}
+}
+
+func TestParseTimeFastPath(t *testing.T) {
+	got, err := ParseTime("15:04:05.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Time{Hour: 15, Minute: 4, Second: 5, Nanosecond: 500000000}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseTimeShortHour(t *testing.T) {
+	// A single-digit hour is accepted, matching the leniency of the "15"
+	// reference layout element under the old time.Parse-based
+	// implementation.
+	got, err := ParseTime("1:02:03")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Time{Hour: 1, Minute: 2, Second: 3}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// Minutes and seconds are not similarly lenient.
+	if _, err := ParseTime("1:2:03"); err == nil {
+		t.Error(`ParseTime("1:2:03"): expected error for single-digit minute, got nil`)
+	}
+}
+
+func TestParseDateTimeFastPath(t *testing.T) {
+	got, err := ParseDateTime("2016-01-02T15:04:05")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DateTime{Date: Date{Year: 2016, Month: 1, Day: 2}, Time: Time{Hour: 15, Minute: 4, Second: 5}}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
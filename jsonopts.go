@@ -0,0 +1,216 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatOptions controls how Marshal, Unmarshal and the MarshalJSONWith /
+// UnmarshalJSONWith methods encode and decode civil.Date, civil.Time and
+// civil.DateTime, for callers who need to interoperate with JSON producers
+// and consumers that do not follow the canonical RFC3339-based encoding
+// used by MarshalJSON and UnmarshalJSON.
+type FormatOptions struct {
+	// AllowNullEmpty causes Unmarshal to treat a JSON null the same as the
+	// zero value, rather than rejecting it with an error.
+	AllowNullEmpty bool
+
+	// DateLayout overrides the layout used to parse and format a Date. The
+	// zero value means RFC3339Date. It has no effect on Time or DateTime.
+	DateLayout Layout
+
+	// AllowZeroDate additionally accepts "0000-00-00" as a Date when
+	// unmarshalling, decoding it to the zero Date. This matches the
+	// behavior of ParseDate.
+	AllowZeroDate bool
+
+	// NanosecondsAsInt marshals a Time as an integer count of nanoseconds
+	// since midnight, and accepts that form (in addition to the canonical
+	// string form) when unmarshalling. It has no effect on Date or
+	// DateTime.
+	NanosecondsAsInt bool
+
+	// TrailingZFallback additionally accepts a DateTime string with a
+	// trailing "Z", as emitted by APIs that attach a spurious UTC marker
+	// to an otherwise civil timestamp, when unmarshalling. The "Z" is
+	// stripped before parsing and never produced when marshalling.
+	TrailingZFallback bool
+}
+
+// DefaultFormatOptions are the options used by MarshalJSON and
+// UnmarshalJSON on Date, Time and DateTime.
+var DefaultFormatOptions = FormatOptions{AllowZeroDate: true}
+
+// Marshal encodes v, which must be a Date, Time or DateTime, as JSON using
+// opts. It returns an error if v is of any other type.
+func Marshal(v interface{}, opts FormatOptions) ([]byte, error) {
+	switch x := v.(type) {
+	case Date:
+		return x.MarshalJSONWith(opts)
+	case Time:
+		return x.MarshalJSONWith(opts)
+	case DateTime:
+		return x.MarshalJSONWith(opts)
+	default:
+		return nil, fmt.Errorf("civil: Marshal: unsupported type %T", v)
+	}
+}
+
+// Unmarshal decodes JSON data into v, which must be a *Date, *Time or
+// *DateTime, using opts. It returns an error if v is of any other type.
+func Unmarshal(data []byte, v interface{}, opts FormatOptions) error {
+	switch x := v.(type) {
+	case *Date:
+		return x.UnmarshalJSONWith(data, opts)
+	case *Time:
+		return x.UnmarshalJSONWith(data, opts)
+	case *DateTime:
+		return x.UnmarshalJSONWith(data, opts)
+	default:
+		return fmt.Errorf("civil: Unmarshal: unsupported type %T", v)
+	}
+}
+
+// MarshalJSONWith encodes d as JSON according to opts.
+func (d Date) MarshalJSONWith(opts FormatOptions) ([]byte, error) {
+	if opts.DateLayout == "" {
+		if y := d.Year; y < 0 || y >= 10000 {
+			// RFC 3339 is clear that years are 4 digits exactly.
+			// See golang.org/issue/4556#c15 for more discussion.
+			return nil, fmt.Errorf("Date.MarshalJSON: year '%v' outside of range [0,9999]", y)
+		}
+		b := make([]byte, 0, len(RFC3339Date)+2)
+		b = append(b, '"')
+		b = append(b, d.String()...)
+		b = append(b, '"')
+		return b, nil
+	}
+	b := make([]byte, 0, len(opts.DateLayout)+2)
+	b = append(b, '"')
+	b = append(b, d.Format(opts.DateLayout)...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSONWith decodes a JSON-encoded Date from data according to
+// opts.
+func (d *Date) UnmarshalJSONWith(data []byte, opts FormatOptions) error {
+	if opts.AllowNullEmpty && string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	s, ok := unquoteSimple(data)
+	if !ok {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("date should be a string, got %s", data)
+		}
+	}
+	if s == "0000-00-00" {
+		if !opts.AllowZeroDate {
+			return fmt.Errorf("invalid date, data: %s, err: zero date not allowed", s)
+		}
+		*d = Date{}
+		return nil
+	}
+	var val Date
+	var err error
+	if opts.DateLayout == "" {
+		val, err = ParseDate(s)
+	} else {
+		val, err = ParseDateIn(opts.DateLayout, s)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid date, data: %s, err: %v", s, err)
+	}
+	*d = val
+	return nil
+}
+
+// MarshalJSONWith encodes t as JSON according to opts.
+func (t Time) MarshalJSONWith(opts FormatOptions) ([]byte, error) {
+	if opts.NanosecondsAsInt {
+		nanos := int64(t.Hour)*int64(3600e9) + int64(t.Minute)*int64(60e9) + int64(t.Second)*1e9 + int64(t.Nanosecond)
+		return strconv.AppendInt(nil, nanos, 10), nil
+	}
+	b := make([]byte, 0, len(RFC3339Time)+2)
+	b = append(b, '"')
+	b = append(b, t.String()...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSONWith decodes a JSON-encoded Time from data according to
+// opts.
+func (t *Time) UnmarshalJSONWith(data []byte, opts FormatOptions) error {
+	if opts.AllowNullEmpty && string(data) == "null" {
+		*t = Time{}
+		return nil
+	}
+	if opts.NanosecondsAsInt && (len(data) == 0 || data[0] != '"') {
+		nanos, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid time, expected nanoseconds as an int, data: %s, err: %v", data, err)
+		}
+		t.Hour = int(nanos / 3600e9)
+		nanos %= 3600e9
+		t.Minute = int(nanos / 60e9)
+		nanos %= 60e9
+		t.Second = int(nanos / 1e9)
+		t.Nanosecond = int(nanos % 1e9)
+		return nil
+	}
+	s, ok := unquoteSimple(data)
+	if !ok {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("time should be a string, got %s", data)
+		}
+	}
+	val, err := ParseTime(s)
+	if err != nil {
+		return fmt.Errorf("invalid time: %v", err)
+	}
+	*t = val
+	return nil
+}
+
+// MarshalJSONWith encodes dt as JSON according to opts. opts is accepted
+// for symmetry with Date.MarshalJSONWith and Time.MarshalJSONWith, but is
+// currently unused: none of the FormatOptions fields affect how a DateTime
+// is marshalled. AllowNullEmpty and TrailingZFallback affect only how one
+// is unmarshalled, and DateLayout/AllowZeroDate and NanosecondsAsInt apply
+// to Date and Time respectively.
+func (dt DateTime) MarshalJSONWith(_ FormatOptions) ([]byte, error) {
+	return dt.MarshalJSON()
+}
+
+// UnmarshalJSONWith decodes a JSON-encoded DateTime from data according to
+// opts.
+func (dt *DateTime) UnmarshalJSONWith(data []byte, opts FormatOptions) error {
+	if opts.AllowNullEmpty && string(data) == "null" {
+		*dt = DateTime{}
+		return nil
+	}
+	if opts.TrailingZFallback {
+		var s string
+		if err := json.Unmarshal(data, &s); err == nil && strings.HasSuffix(s, "Z") {
+			data, _ = json.Marshal(strings.TrimSuffix(s, "Z"))
+		}
+	}
+	return dt.UnmarshalJSON(data)
+}
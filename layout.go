@@ -0,0 +1,110 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// A Layout is a reference-time layout, in the same form accepted by
+// time.Parse and time.Format, identifying one of the wire formats that
+// ParseDateIn, ParseTimeIn, ParseDateTimeIn and the Format methods accept in
+// addition to the RFC3339 forms used by ParseDate, ParseTime and
+// ParseDateTime.
+type Layout string
+
+// Wire formats for Date, beyond RFC3339Date, that civil APIs such as
+// Swagger/OpenAPI, HTTP headers and legacy CSV feeds commonly use.
+const (
+	// LayoutRFC1123Date is the date portion of RFC1123, as used in HTTP
+	// headers such as Last-Modified ("Mon, 02 Jan 2006").
+	LayoutRFC1123Date Layout = "Mon, 02 Jan 2006"
+
+	// LayoutISO8601Basic is the ISO 8601 basic (no separators) calendar
+	// date format ("20060102").
+	LayoutISO8601Basic Layout = "20060102"
+
+	// LayoutOrdinal is the ISO 8601 ordinal date format, a four-digit year
+	// and three-digit day of year ("2006-002").
+	LayoutOrdinal Layout = "2006-002"
+)
+
+// Wire formats for Time, beyond RFC3339Time.
+const (
+	// LayoutISO8601BasicTime is the ISO 8601 basic (no separators) time
+	// of day format ("150405").
+	LayoutISO8601BasicTime Layout = "150405"
+)
+
+// Wire formats for DateTime, beyond RFC3339DateTime.
+const (
+	// LayoutRFC1123DateTime is RFC1123 without the trailing zone, as used
+	// in HTTP headers once the zone has been normalized away ("Mon, 02 Jan
+	// 2006 15:04:05").
+	LayoutRFC1123DateTime Layout = "Mon, 02 Jan 2006 15:04:05"
+
+	// LayoutISO8601BasicDateTime is the ISO 8601 basic (no separators)
+	// date-time format ("20060102T150405").
+	LayoutISO8601BasicDateTime Layout = "20060102T150405"
+)
+
+// ParseDateIn parses a string in the given layout, a reference-time layout
+// as accepted by time.Parse, and returns the Date value it represents.
+// Any offset or zone present in layout is parsed but discarded: like
+// ParseDate, ParseDateIn is location-independent.
+func ParseDateIn(layout Layout, s string) (Date, error) {
+	t, err := time.Parse(string(layout), s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// Format returns the date formatted according to layout, a reference-time
+// layout as accepted by time.Format.
+func (d Date) Format(layout Layout) string {
+	return d.In(time.UTC).Format(string(layout))
+}
+
+// ParseTimeIn parses a string in the given layout, a reference-time layout
+// as accepted by time.Parse, and returns the Time value it represents.
+func ParseTimeIn(layout Layout, s string) (Time, error) {
+	t, err := time.Parse(string(layout), s)
+	if err != nil {
+		return Time{}, err
+	}
+	return TimeOf(t), nil
+}
+
+// Format returns the time formatted according to layout, a reference-time
+// layout as accepted by time.Format.
+func (t Time) Format(layout Layout) string {
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC).Format(string(layout))
+}
+
+// ParseDateTimeIn parses a string in the given layout, a reference-time
+// layout as accepted by time.Parse, and returns the DateTime value it
+// represents.
+func ParseDateTimeIn(layout Layout, s string) (DateTime, error) {
+	t, err := time.Parse(string(layout), s)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTimeOf(t), nil
+}
+
+// Format returns the date-time formatted according to layout, a
+// reference-time layout as accepted by time.Format.
+func (dt DateTime) Format(layout Layout) string {
+	return dt.In(time.UTC).Format(string(layout))
+}
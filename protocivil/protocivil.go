@@ -0,0 +1,195 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protocivil converts between civil.Date, civil.Time and
+// civil.DateTime and their google.type protobuf equivalents
+// (google.type.Date, google.type.TimeOfDay and google.type.DateTime),
+// so that civil values embed naturally in gRPC/Connect service
+// definitions built on the Google API ecosystem civil originated from.
+//
+// civil.DateTime has no location, so converting to and from
+// google.type.DateTime always leaves the TimeOffset oneof unset; a
+// DateTime with a TimeZone or UtcOffset set does describe a unique
+// moment and has no lossless civil.DateTime representation.
+package protocivil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openlyinc/civil"
+	date "google.golang.org/genproto/googleapis/type/date"
+	datetime "google.golang.org/genproto/googleapis/type/datetime"
+	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FromProtoDate converts a google.type.Date to a civil.Date. A nil p, or a
+// p with Year, Month and Day all zero (the documented way to represent a
+// date with no specific value), converts to the zero civil.Date.
+func FromProtoDate(p *date.Date) civil.Date {
+	if p == nil {
+		return civil.Date{}
+	}
+	return civil.Date{
+		Year:  int(p.GetYear()),
+		Month: time.Month(p.GetMonth()),
+		Day:   int(p.GetDay()),
+	}
+}
+
+// ToProtoDate converts a civil.Date to a google.type.Date.
+func ToProtoDate(d civil.Date) *date.Date {
+	return &date.Date{
+		Year:  int32(d.Year),
+		Month: int32(d.Month),
+		Day:   int32(d.Day),
+	}
+}
+
+// FromProtoTimeOfDay converts a google.type.TimeOfDay to a civil.Time. A
+// nil p converts to the zero civil.Time.
+func FromProtoTimeOfDay(p *timeofday.TimeOfDay) civil.Time {
+	if p == nil {
+		return civil.Time{}
+	}
+	return civil.Time{
+		Hour:       int(p.GetHours()),
+		Minute:     int(p.GetMinutes()),
+		Second:     int(p.GetSeconds()),
+		Nanosecond: int(p.GetNanos()),
+	}
+}
+
+// ToProtoTimeOfDay converts a civil.Time to a google.type.TimeOfDay.
+func ToProtoTimeOfDay(t civil.Time) *timeofday.TimeOfDay {
+	return &timeofday.TimeOfDay{
+		Hours:   int32(t.Hour),
+		Minutes: int32(t.Minute),
+		Seconds: int32(t.Second),
+		Nanos:   int32(t.Nanosecond),
+	}
+}
+
+// FromProtoDateTime converts a google.type.DateTime to a civil.DateTime,
+// discarding any TimeOffset (TimeZone or UtcOffset) the message carries. A
+// nil p converts to the zero civil.DateTime.
+func FromProtoDateTime(p *datetime.DateTime) civil.DateTime {
+	if p == nil {
+		return civil.DateTime{}
+	}
+	return civil.DateTime{
+		Date: civil.Date{
+			Year:  int(p.GetYear()),
+			Month: time.Month(p.GetMonth()),
+			Day:   int(p.GetDay()),
+		},
+		Time: civil.Time{
+			Hour:       int(p.GetHours()),
+			Minute:     int(p.GetMinutes()),
+			Second:     int(p.GetSeconds()),
+			Nanosecond: int(p.GetNanos()),
+		},
+	}
+}
+
+// ToProtoDateTime converts a civil.DateTime to a google.type.DateTime. The
+// returned message's TimeOffset oneof is left unset, matching the civil
+// DateTime's lack of location information.
+func ToProtoDateTime(dt civil.DateTime) *datetime.DateTime {
+	return &datetime.DateTime{
+		Year:    int32(dt.Date.Year),
+		Month:   int32(dt.Date.Month),
+		Day:     int32(dt.Date.Day),
+		Hours:   int32(dt.Time.Hour),
+		Minutes: int32(dt.Time.Minute),
+		Seconds: int32(dt.Time.Second),
+		Nanos:   int32(dt.Time.Nanosecond),
+	}
+}
+
+// MarshalDateJSON renders d using protojson, as its google.type.Date
+// representation would be marshalled inside a surrounding proto message
+// (e.g. {"year":2024,"month":1,"day":2}).
+func MarshalDateJSON(d civil.Date) ([]byte, error) {
+	return protojson.Marshal(ToProtoDate(d))
+}
+
+// UnmarshalDateJSON parses data, the protojson encoding of a
+// google.type.Date, into d.
+func UnmarshalDateJSON(data []byte, d *civil.Date) error {
+	var p date.Date
+	if err := protojson.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	*d = FromProtoDate(&p)
+	return nil
+}
+
+// MarshalTimeOfDayJSON renders t using protojson, as its
+// google.type.TimeOfDay representation would be marshalled inside a
+// surrounding proto message.
+func MarshalTimeOfDayJSON(t civil.Time) ([]byte, error) {
+	return protojson.Marshal(ToProtoTimeOfDay(t))
+}
+
+// UnmarshalTimeOfDayJSON parses data, the protojson encoding of a
+// google.type.TimeOfDay, into t.
+func UnmarshalTimeOfDayJSON(data []byte, t *civil.Time) error {
+	var p timeofday.TimeOfDay
+	if err := protojson.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	*t = FromProtoTimeOfDay(&p)
+	return nil
+}
+
+// MarshalDateTimeJSON renders dt using protojson, as its
+// google.type.DateTime representation would be marshalled inside a
+// surrounding proto message. The TimeOffset oneof is never set.
+func MarshalDateTimeJSON(dt civil.DateTime) ([]byte, error) {
+	return protojson.Marshal(ToProtoDateTime(dt))
+}
+
+// UnmarshalDateTimeJSON parses data, the protojson encoding of a
+// google.type.DateTime, into dt. It returns an error if data has a
+// TimeOffset set; see CheckNoOffset.
+func UnmarshalDateTimeJSON(data []byte, dt *civil.DateTime) error {
+	var p datetime.DateTime
+	if err := protojson.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	if err := CheckNoOffset(&p); err != nil {
+		return err
+	}
+	*dt = FromProtoDateTime(&p)
+	return nil
+}
+
+// CheckNoOffset returns an error if p has a TimeOffset set, i.e. it
+// describes a unique moment rather than a civil date-time. Callers that
+// use FromProtoDateTime to populate a civil.DateTime should call this
+// first if silently discarding the offset would be incorrect for their
+// API.
+func CheckNoOffset(p *datetime.DateTime) error {
+	if p == nil {
+		return nil
+	}
+	switch p.GetTimeOffset().(type) {
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("protocivil: DateTime has a TimeOffset set; it does not represent a civil.DateTime")
+	}
+}
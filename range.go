@@ -0,0 +1,68 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "iter"
+
+// A DateRange represents a contiguous, inclusive span of dates from Start
+// to End. A DateRange where End is before Start contains no dates.
+type DateRange struct {
+	Start, End Date
+}
+
+// Contains reports whether d falls within r, inclusive of both ends.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// Overlaps reports whether r and o share at least one date.
+func (r DateRange) Overlaps(o DateRange) bool {
+	return !r.End.Before(o.Start) && !o.End.Before(r.Start)
+}
+
+// Days returns the number of dates in r, inclusive of both ends. It is
+// zero or negative if r.End is before r.Start.
+func (r DateRange) Days() int {
+	return r.End.DaysSince(r.Start) + 1
+}
+
+// Iter returns a sequence over every date in r, from Start to End
+// inclusive, in order. It yields nothing if r.End is before r.Start.
+func (r DateRange) Iter() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := r.Start; !d.After(r.End); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// A DateTimeRange represents a contiguous, inclusive span of date-times
+// from Start to End. A DateTimeRange where End is before Start contains
+// no date-times.
+type DateTimeRange struct {
+	Start, End DateTime
+}
+
+// Contains reports whether dt falls within r, inclusive of both ends.
+func (r DateTimeRange) Contains(dt DateTime) bool {
+	return !dt.Before(r.Start) && !dt.After(r.End)
+}
+
+// Overlaps reports whether r and o share at least one instant.
+func (r DateTimeRange) Overlaps(o DateTimeRange) bool {
+	return !r.End.Before(o.Start) && !o.End.Before(r.Start)
+}
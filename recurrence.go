@@ -0,0 +1,142 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// A Freq identifies how often a Recurrence repeats, matching the FREQ
+// values of RFC 5545's RRULE that civil.Recurrence supports.
+type Freq int
+
+const (
+	Daily Freq = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// A Recurrence describes a repeating pattern of Dates. It implements the
+// subset of RFC 5545's RRULE most commonly needed for scheduling: FREQ,
+// INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL. It does not attempt to
+// support the full RRULE grammar.
+type Recurrence struct {
+	// Freq is how often the pattern repeats.
+	Freq Freq
+
+	// Interval is the gap between occurrences, measured in units of Freq
+	// (e.g. every 2nd week, for Freq == Weekly). Zero or negative means 1.
+	Interval int
+
+	// ByDay restricts a Weekly recurrence to the given weekdays. If empty,
+	// a Weekly recurrence falls on the same weekday as the start date
+	// passed to Occurrences.
+	ByDay []time.Weekday
+
+	// ByMonthDay restricts a Monthly or Yearly recurrence to the given
+	// days of the month. If empty, the recurrence falls on the same day
+	// of the month as the start date passed to Occurrences.
+	ByMonthDay []int
+
+	// Count, if positive, stops the recurrence after this many
+	// occurrences.
+	Count int
+
+	// Until, if not the zero Date, stops the recurrence at or before this
+	// date.
+	Until Date
+}
+
+// Occurrences returns the dates on which r recurs starting at from
+// (inclusive), not extending past to or r.Until (whichever is earlier),
+// and not exceeding r.Count occurrences.
+func (r Recurrence) Occurrences(from, to Date) []Date {
+	end := to
+	if r.Until != (Date{}) && r.Until.Before(end) {
+		end = r.Until
+	}
+	var out []Date
+	for d := from; !d.After(end); d = d.AddDays(1) {
+		if r.matches(from, d) {
+			out = append(out, d)
+			if r.Count > 0 && len(out) >= r.Count {
+				break
+			}
+		}
+	}
+	return out
+}
+
+func (r Recurrence) interval() int {
+	if r.Interval <= 0 {
+		return 1
+	}
+	return r.Interval
+}
+
+// matches reports whether d is an occurrence of r anchored at from. It
+// assumes d is not before from.
+func (r Recurrence) matches(from, d Date) bool {
+	interval := r.interval()
+	switch r.Freq {
+	case Daily:
+		return d.DaysSince(from)%interval == 0
+
+	case Weekly:
+		weekStart := d.DaysSince(from) + int(from.Weekday())
+		if (weekStart/7)%interval != 0 {
+			return false
+		}
+		if len(r.ByDay) == 0 {
+			return d.Weekday() == from.Weekday()
+		}
+		for _, wd := range r.ByDay {
+			if d.Weekday() == wd {
+				return true
+			}
+		}
+		return false
+
+	case Monthly:
+		months := (d.Year-from.Year)*12 + int(d.Month) - int(from.Month)
+		if months%interval != 0 {
+			return false
+		}
+		if len(r.ByMonthDay) == 0 {
+			return d.Day == from.Day
+		}
+		for _, md := range r.ByMonthDay {
+			if d.Day == md {
+				return true
+			}
+		}
+		return false
+
+	case Yearly:
+		years := d.Year - from.Year
+		if years%interval != 0 || d.Month != from.Month {
+			return false
+		}
+		if len(r.ByMonthDay) == 0 {
+			return d.Day == from.Day
+		}
+		for _, md := range r.ByMonthDay {
+			if d.Day == md {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "fmt"
+
+// MarshalTOML implements the unstable.Marshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Encoder.EnableMarshalerInterface).
+// The returned bytes are the bare local-date literal ("2006-01-02"), with no
+// surrounding quotes, matching how the TOML local-date type is written.
+func (d Date) MarshalTOML() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalTOML implements the unstable.Unmarshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Decoder.EnableUnmarshalerInterface).
+// data is the raw local-date literal as it appeared in the document, already
+// in the same "2006-01-02" format ParseDate accepts, so it is parsed
+// directly with no intermediate representation needed.
+func (d *Date) UnmarshalTOML(data []byte) error {
+	val, err := ParseDate(string(data))
+	if err != nil {
+		return fmt.Errorf("civil: UnmarshalTOML: invalid date, data: %s, err: %v", data, err)
+	}
+	*d = val
+	return nil
+}
+
+// MarshalTOML implements the unstable.Marshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Encoder.EnableMarshalerInterface).
+// The returned bytes are the bare local-time literal, matching how the TOML
+// local-time type is written.
+func (t Time) MarshalTOML() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalTOML implements the unstable.Unmarshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Decoder.EnableUnmarshalerInterface).
+// data is the raw local-time literal as it appeared in the document, parsed
+// directly with ParseTime.
+func (t *Time) UnmarshalTOML(data []byte) error {
+	val, err := ParseTime(string(data))
+	if err != nil {
+		return fmt.Errorf("civil: UnmarshalTOML: invalid time, data: %s, err: %v", data, err)
+	}
+	*t = val
+	return nil
+}
+
+// MarshalTOML implements the unstable.Marshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Encoder.EnableMarshalerInterface).
+// The returned bytes are the bare local-date-time literal, matching how the
+// TOML local-datetime type is written.
+func (dt DateTime) MarshalTOML() ([]byte, error) {
+	return []byte(dt.String()), nil
+}
+
+// UnmarshalTOML implements the unstable.Unmarshaler interface used by
+// github.com/pelletier/go-toml/v2 (see its Decoder.EnableUnmarshalerInterface).
+// data is the raw local-date-time literal as it appeared in the document,
+// parsed directly with ParseDateTime.
+func (dt *DateTime) UnmarshalTOML(data []byte) error {
+	val, err := ParseDateTime(string(data))
+	if err != nil {
+		return fmt.Errorf("civil: UnmarshalTOML: invalid datetime, data: %s, err: %v", data, err)
+	}
+	*dt = val
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tomlcodec provides a Marshal/Unmarshal pair for embedding
+// civil.Date, civil.Time and civil.DateTime values in TOML documents via
+// github.com/pelletier/go-toml/v2, so that they round-trip through TOML's
+// local-date, local-time and local-datetime types instead of being forced
+// through time.Time and a bogus UTC location.
+//
+// civil.Date, civil.Time and civil.DateTime implement the unstable.Marshaler
+// and unstable.Unmarshaler interfaces go-toml/v2 uses for custom encoding,
+// but go-toml/v2 only consults them when that interface support is
+// explicitly enabled on the Encoder/Decoder (it is off by default, in which
+// case encoding.TextMarshaler wins instead and civil values are emitted as
+// quoted strings, and decoding a genuine local-date/local-time/local-datetime
+// document fails outright). Marshal and Unmarshal exist to turn that support
+// on, so callers get genuine local-date/local-time/local-datetime values
+// rather than quoted strings.
+//
+// This package is specific to go-toml/v2: its unstable.Unmarshaler interface
+// takes the raw literal bytes ([]byte), which is what civil.Date,
+// civil.Time and civil.DateTime implement here. github.com/BurntSushi/toml
+// defines an Unmarshaler interface of the same name but a different, and
+// incompatible, method signature, so it is not supported by this package.
+package tomlcodec
+
+import (
+	"bytes"
+
+	toml "github.com/pelletier/go-toml/v2"
+)
+
+// Marshal encodes v as TOML. v is typically a struct (or pointer to one)
+// with civil.Date, civil.Time or civil.DateTime fields, which are written
+// out as TOML local-date, local-time and local-datetime values.
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).EnableMarshalerInterface().Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes TOML data into v. Any civil.Date, civil.Time or
+// civil.DateTime fields in v are populated from the corresponding
+// local-date, local-time or local-datetime values in data.
+func Unmarshal(data []byte, v interface{}) error {
+	return toml.NewDecoder(bytes.NewReader(data)).EnableUnmarshalerInterface().Decode(v)
+}
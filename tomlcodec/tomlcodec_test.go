@@ -0,0 +1,79 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tomlcodec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openlyinc/civil"
+)
+
+type config struct {
+	Birthday  civil.Date     `toml:"birthday"`
+	OpensAt   civil.Time     `toml:"opens_at"`
+	CreatedAt civil.DateTime `toml:"created_at"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	want := config{
+		Birthday:  civil.Date{Year: 1987, Month: 6, Day: 5},
+		OpensAt:   civil.Time{Hour: 9, Minute: 30, Second: 0},
+		CreatedAt: civil.DateTime{Date: civil.Date{Year: 2024, Month: 1, Day: 2}, Time: civil.Time{Hour: 3, Minute: 4, Second: 5}},
+	}
+
+	data, err := Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	// The whole point of this package is emitting genuine TOML local-date
+	// values, not quoted strings; assert that directly so a regression to
+	// plain toml.Marshal (which would quote it) fails loudly.
+	if !strings.Contains(string(data), "birthday = 1987-06-05") {
+		t.Errorf("Marshal output did not contain an unquoted local-date literal, got:\n%s", data)
+	}
+
+	var got config
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalGenuineLocalTypes(t *testing.T) {
+	// Hand-written document using real TOML local-date/local-time/
+	// local-datetime literals, as a third-party producer would emit them
+	// (and as plain toml.Unmarshal, without the Unmarshaler interface
+	// enabled, fails to decode into these struct fields).
+	doc := []byte("birthday = 1987-06-05\nopens_at = 09:30:00\ncreated_at = 2024-01-02T03:04:05\n")
+
+	var got config
+	if err := Unmarshal(doc, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	want := config{
+		Birthday:  civil.Date{Year: 1987, Month: 6, Day: 5},
+		OpensAt:   civil.Time{Hour: 9, Minute: 30, Second: 0},
+		CreatedAt: civil.DateTime{Date: civil.Date{Year: 2024, Month: 1, Day: 2}, Time: civil.Time{Hour: 3, Minute: 4, Second: 5}},
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// Weekday returns the day of the week specified by d.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+// Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
+// week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
+// of year n+1.
+func (d Date) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+// DayOfYear returns the day of the year specified by d, where January 1
+// is 1.
+func (d Date) DayOfYear() int {
+	return d.DaysSince(Date{Year: d.Year, Month: time.January, Day: 1}) + 1
+}
+
+// NextWeekday returns the first date after d, not including d itself,
+// that falls on weekday.
+func (d Date) NextWeekday(weekday time.Weekday) Date {
+	next := d.AddDays(1)
+	for next.Weekday() != weekday {
+		next = next.AddDays(1)
+	}
+	return next
+}